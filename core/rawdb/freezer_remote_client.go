@@ -22,6 +22,10 @@ type FreezerRemoteClient struct {
 	closeOnce sync.Once
 }
 
+// FreezerRemoteClient must satisfy the ancient store contract expected by the
+// database wrappers in this package.
+var _ ethdb.AncientStore = (*FreezerRemoteClient)(nil)
+
 const (
 	FreezerMethodClose            = "freezer_close"
 	FreezerMethodHasAncient       = "freezer_hasAncient"
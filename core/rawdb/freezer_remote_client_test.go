@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/cmd/ancient-store-mem/lib"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -96,3 +97,53 @@ func TestClient1(t *testing.T) {
 		t.Fatalf("got: %d, want: 670", n)
 	}
 }
+
+// TestClientAncientStore exercises every ethdb.AncientStore method through the
+// interface type rather than the concrete client.
+func TestClientAncientStore(t *testing.T) {
+	server := newTestServer(t)
+	client := rpc.DialInProc(server)
+
+	var store ethdb.AncientStore = &FreezerRemoteClient{
+		client: client,
+		quit:   make(chan struct{}),
+	}
+
+	for i := uint64(0); i < 3; i++ {
+		b := []byte{uint8(i)}
+		if err := store.AppendAncient(i, b, b, b, b, b); err != nil {
+			t.Fatalf("append %d: %v", i, err)
+		}
+	}
+	if err := store.Sync(); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if n, err := store.Ancients(); err != nil || n != 3 {
+		t.Fatalf("ancients: got %d (%v), want 3", n, err)
+	}
+	if ok, err := store.HasAncient(FreezerRemoteHeaderTable, 2); err != nil || !ok {
+		t.Fatalf("has ancient: %v %v", ok, err)
+	}
+	v, err := store.Ancient(FreezerRemoteBodiesTable, 1)
+	if err != nil {
+		t.Fatalf("ancient: %v", err)
+	}
+	if !bytes.Equal(v, []byte{1}) {
+		t.Fatalf("mismatch store value: want: %x, got: %x", []byte{1}, v)
+	}
+	if size, err := store.AncientSize(FreezerRemoteReceiptTable); err != nil || size != 3 {
+		t.Fatalf("ancient size: got %d (%v), want 3", size, err)
+	}
+	if err := store.TruncateAncients(1); err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+	if n, err := store.Ancients(); err != nil || n != 1 {
+		t.Fatalf("ancients after truncate: got %d (%v), want 1", n, err)
+	}
+	if ok, err := store.HasAncient(FreezerRemoteHeaderTable, 1); err != nil || ok {
+		t.Fatalf("has truncated ancient: %v %v", ok, err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+}